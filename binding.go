@@ -0,0 +1,72 @@
+package monday
+
+import (
+	"context"
+	"sync"
+
+	"github.com/machinebox/graphql"
+)
+
+// Binding describes a single typed Monday GraphQL operation: the document to
+// send and how to turn a request value into the GraphQL variables it needs.
+// Res is the shape of the response envelope Monday returns for Document,
+// decoded directly via json tags - the same way the built-in Client methods
+// declare their response structs today.
+type Binding[Req any, Res any] interface {
+	// Document returns the GraphQL query or mutation text.
+	Document() string
+	// Vars returns the GraphQL variables for the given request value.
+	Vars(req Req) map[string]interface{}
+}
+
+// Execute runs b against c using req and decodes the response envelope into
+// a Res. Callers that need paging should wrap b in a Paginator instead of
+// calling Execute directly. If b.Document() contains complexityPlaceholder
+// (as the built-in itemsBinding does), it is resolved the same way as every
+// other built-in document - see injectComplexity.
+func Execute[Req any, Res any](ctx context.Context, c *Client, b Binding[Req, Res], req Req) (Res, error) {
+	var res Res
+	gqlReq := graphql.NewRequest(c.injectComplexity(b.Document()))
+	for name, value := range b.Vars(req) {
+		gqlReq.Var(name, value)
+	}
+	err := c.runRequestContext(ctx, gqlReq, &res)
+	return res, err
+}
+
+// Schema is a registry of named Bindings, letting callers register custom
+// queries and mutations (custom fields, custom columns, ...) and run them
+// through the same Client used for the built-in operations.
+type Schema struct {
+	mu       sync.RWMutex
+	bindings map[string]interface{}
+}
+
+// NewSchema returns an empty Schema ready for Register calls.
+func NewSchema() *Schema {
+	return &Schema{bindings: make(map[string]interface{})}
+}
+
+// Register adds b to s under name, replacing any binding already registered
+// under that name.
+func Register[Req any, Res any](s *Schema, name string, b Binding[Req, Res]) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.bindings[name] = b
+}
+
+// Lookup returns the binding registered under name. The second return value
+// is false if name is not registered, or it is registered with a different
+// Req type than requested. Res is not checked: Binding's methods do not
+// mention Res, so a binding registered under any Res type is returned for a
+// Lookup whose Req matches.
+func Lookup[Req any, Res any](s *Schema, name string) (Binding[Req, Res], bool) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	b, ok := s.bindings[name]
+	if !ok {
+		return nil, false
+	}
+	typed, ok := b.(Binding[Req, Res])
+	return typed, ok
+}
@@ -0,0 +1,69 @@
+package monday
+
+import "testing"
+
+type fakeBindingReq struct {
+	X int
+}
+
+type fakeBindingRes struct {
+	Y int
+}
+
+// fakeBinding is a minimal Binding used to exercise Schema without a live
+// Client.
+type fakeBinding struct{}
+
+func (fakeBinding) Document() string { return "query { x }" }
+func (fakeBinding) Vars(req fakeBindingReq) map[string]interface{} {
+	return map[string]interface{}{"x": req.X}
+}
+
+// otherFakeBinding has the same Req as fakeBinding but a different
+// Document, so a test can tell which of the two a Lookup returned.
+type otherFakeBinding struct{}
+
+func (otherFakeBinding) Document() string { return "query { y }" }
+func (otherFakeBinding) Vars(req fakeBindingReq) map[string]interface{} {
+	return map[string]interface{}{"y": req.X}
+}
+
+func TestSchemaRegisterLookup(t *testing.T) {
+	s := NewSchema()
+	Register[fakeBindingReq, fakeBindingRes](s, "custom", fakeBinding{})
+
+	if _, ok := Lookup[fakeBindingReq, fakeBindingRes](s, "custom"); !ok {
+		t.Fatal("Lookup after Register: ok = false, want true")
+	}
+}
+
+func TestSchemaLookupUnregisteredName(t *testing.T) {
+	s := NewSchema()
+	if _, ok := Lookup[fakeBindingReq, fakeBindingRes](s, "missing"); ok {
+		t.Fatal("Lookup for unregistered name: ok = true, want false")
+	}
+}
+
+func TestSchemaLookupReqTypeMismatch(t *testing.T) {
+	s := NewSchema()
+	Register[fakeBindingReq, fakeBindingRes](s, "custom", fakeBinding{})
+
+	type otherReq struct{ Z string }
+	if _, ok := Lookup[otherReq, fakeBindingRes](s, "custom"); ok {
+		t.Fatal("Lookup with mismatched Req type: ok = true, want false")
+	}
+}
+
+func TestSchemaRegisterReplacesExisting(t *testing.T) {
+	s := NewSchema()
+	Register[fakeBindingReq, fakeBindingRes](s, "custom", fakeBinding{})
+	Register[fakeBindingReq, fakeBindingRes](s, "custom", otherFakeBinding{})
+
+	b, ok := Lookup[fakeBindingReq, fakeBindingRes](s, "custom")
+	if !ok {
+		t.Fatal("Lookup after re-Register: ok = false, want true")
+	}
+	if got, want := b.Document(), (otherFakeBinding{}).Document(); got != want {
+		t.Fatalf("Lookup after re-Register: Document() = %q, want %q (the replacement)", got, want)
+	}
+}
@@ -0,0 +1,262 @@
+package monday
+
+import (
+	"encoding/json"
+	"errors"
+	"sync"
+)
+
+// Column value types beyond the handful in monday.go: one struct per Monday
+// column type, matching the shape of its "value" JSON as documented by the
+// Monday API. Use the Build* functions to construct a value for AddItem /
+// ChangeColumnValue, and DecodeValue to read one back.
+type Email struct {
+	Email string `json:"email"`
+	Text  string `json:"text"`
+}
+type Phone struct {
+	Phone            string `json:"phone"`
+	CountryShortName string `json:"countryShortName"`
+}
+type Link struct {
+	Url  string `json:"url"`
+	Text string `json:"text"`
+}
+type LongText struct {
+	Text string `json:"text"`
+}
+type Numeric struct {
+	Value float64 `json:"value"`
+}
+type Rating struct {
+	Rating int `json:"rating"`
+}
+type Timeline struct {
+	From string `json:"from"`
+	To   string `json:"to"`
+}
+type Week struct {
+	StartDate string `json:"startDate"`
+	EndDate   string `json:"endDate"`
+}
+type WeekValue struct {
+	Week Week `json:"week"`
+}
+type Hour struct {
+	Hour   int `json:"hour"`
+	Minute int `json:"minute"`
+}
+type Location struct {
+	Lat     string `json:"lat"`
+	Lng     string `json:"lng"`
+	Address string `json:"address"`
+}
+type Country struct {
+	CountryCode string `json:"countryCode"`
+	CountryName string `json:"countryName"`
+}
+type Tag struct {
+	TagIds []int `json:"tag_ids"`
+}
+type FileInfo struct {
+	Name    string `json:"name"`
+	AssetId int    `json:"assetId"`
+}
+type FileValue struct {
+	Files []FileInfo `json:"files"`
+}
+type LinkedItem struct {
+	LinkedPulseId int `json:"linkedPulseId"`
+}
+type BoardRelation struct {
+	LinkedPulseIds []LinkedItem `json:"linkedPulseIds"`
+}
+
+func BuildEmail(email, text string) Email {
+	return Email{Email: email, Text: text}
+}
+func BuildPhone(phone, countryShortName string) Phone {
+	return Phone{Phone: phone, CountryShortName: countryShortName}
+}
+func BuildLink(url, text string) Link {
+	return Link{Url: url, Text: text}
+}
+func BuildLongText(text string) LongText {
+	return LongText{Text: text}
+}
+func BuildNumeric(value float64) Numeric {
+	return Numeric{Value: value}
+}
+func BuildRating(rating int) Rating {
+	return Rating{Rating: rating}
+}
+func BuildTimeline(from, to string) Timeline {
+	return Timeline{From: from, To: to}
+}
+func BuildWeek(startDate, endDate string) WeekValue {
+	return WeekValue{Week: Week{StartDate: startDate, EndDate: endDate}}
+}
+func BuildHour(hour, minute int) Hour {
+	return Hour{Hour: hour, Minute: minute}
+}
+func BuildLocation(lat, lng, address string) Location {
+	return Location{Lat: lat, Lng: lng, Address: address}
+}
+func BuildCountry(countryCode, countryName string) Country {
+	return Country{CountryCode: countryCode, CountryName: countryName}
+}
+func BuildTag(tagIds ...int) Tag {
+	return Tag{TagIds: tagIds}
+}
+func BuildBoardRelation(itemIds ...int) BoardRelation {
+	linked := make([]LinkedItem, len(itemIds))
+	for i, id := range itemIds {
+		linked[i] = LinkedItem{LinkedPulseId: id}
+	}
+	return BoardRelation{LinkedPulseIds: linked}
+}
+
+// ColumnValueCodec encodes a Go value into the JSON Monday expects for a
+// column's value, and decodes a column's raw value back into a Go value.
+// settings is the column's settings_str (see Column), for codecs that need
+// it, e.g. to resolve a color/dropdown label index against the column's
+// labels. The built-in codecs ignore it: color and dropdown decode to the
+// raw index/ids without resolving them.
+type ColumnValueCodec interface {
+	Encode(v interface{}) (json.RawMessage, error)
+	Decode(raw json.RawMessage, settings string) (interface{}, error)
+}
+
+// funcCodec adapts a pair of encode/decode functions to ColumnValueCodec,
+// avoiding a dedicated named type per column type.
+type funcCodec struct {
+	encode func(interface{}) (json.RawMessage, error)
+	decode func(raw json.RawMessage, settings string) (interface{}, error)
+}
+
+func (f funcCodec) Encode(v interface{}) (json.RawMessage, error) {
+	return f.encode(v)
+}
+func (f funcCodec) Decode(raw json.RawMessage, settings string) (interface{}, error) {
+	return f.decode(raw, settings)
+}
+
+// columnCodecs is the registry DecodeValue dispatches through, keyed by
+// Column.Type. RegisterColumnValueCodec adds to it, so callers can plug in
+// codecs for custom column types Monday apps define.
+var (
+	columnCodecsMu sync.RWMutex
+	columnCodecs   = map[string]ColumnValueCodec{}
+)
+
+// RegisterColumnValueCodec registers codec for columnType, replacing any
+// codec already registered under that type.
+func RegisterColumnValueCodec(columnType string, codec ColumnValueCodec) {
+	columnCodecsMu.Lock()
+	defer columnCodecsMu.Unlock()
+	columnCodecs[columnType] = codec
+}
+
+// marshalJSON is the Encode half shared by codecs whose Go value already
+// marshals to the JSON shape Monday expects.
+func marshalJSON(v interface{}) (json.RawMessage, error) {
+	b, err := json.Marshal(v)
+	return json.RawMessage(b), err
+}
+
+// decodeInto is the Decode half shared by codecs that just unmarshal raw
+// into a pointer to a zero value of T and return it.
+func decodeInto[T any](raw json.RawMessage) (interface{}, error) {
+	var val T
+	if err := json.Unmarshal(raw, &val); err != nil {
+		return nil, err
+	}
+	return val, nil
+}
+
+func init() {
+	RegisterColumnValueCodec("text", funcCodec{
+		encode: marshalJSON,
+		decode: func(raw json.RawMessage, _ string) (interface{}, error) {
+			var text string
+			if err := json.Unmarshal(raw, &text); err != nil {
+				return nil, err
+			}
+			return text, nil
+		},
+	})
+	RegisterColumnValueCodec("color", funcCodec{
+		encode: marshalJSON,
+		decode: func(raw json.RawMessage, _ string) (interface{}, error) {
+			return decodeInto[StatusIndex](raw)
+		},
+	})
+	RegisterColumnValueCodec("boolean", funcCodec{
+		encode: marshalJSON,
+		decode: func(raw json.RawMessage, _ string) (interface{}, error) {
+			return decodeInto[Checkbox](raw)
+		},
+	})
+	RegisterColumnValueCodec("date", funcCodec{
+		encode: marshalJSON,
+		decode: func(raw json.RawMessage, _ string) (interface{}, error) {
+			return decodeInto[DateTime](raw)
+		},
+	})
+	RegisterColumnValueCodec("multiple-person", funcCodec{
+		encode: marshalJSON,
+		decode: func(raw json.RawMessage, _ string) (interface{}, error) {
+			return DecodePeople(string(raw))
+		},
+	})
+	RegisterColumnValueCodec("dropdown", funcCodec{
+		encode: marshalJSON,
+		decode: func(raw json.RawMessage, _ string) (interface{}, error) {
+			return DecodeDropDown(string(raw))
+		},
+	})
+	RegisterColumnValueCodec("email", funcCodec{encode: marshalJSON, decode: decodeIntoFunc[Email]()})
+	RegisterColumnValueCodec("phone", funcCodec{encode: marshalJSON, decode: decodeIntoFunc[Phone]()})
+	RegisterColumnValueCodec("link", funcCodec{encode: marshalJSON, decode: decodeIntoFunc[Link]()})
+	RegisterColumnValueCodec("long-text", funcCodec{encode: marshalJSON, decode: decodeIntoFunc[LongText]()})
+	RegisterColumnValueCodec("numeric", funcCodec{encode: marshalJSON, decode: decodeIntoFunc[Numeric]()})
+	RegisterColumnValueCodec("rating", funcCodec{encode: marshalJSON, decode: decodeIntoFunc[Rating]()})
+	RegisterColumnValueCodec("timeline", funcCodec{encode: marshalJSON, decode: decodeIntoFunc[Timeline]()})
+	RegisterColumnValueCodec("week", funcCodec{encode: marshalJSON, decode: decodeIntoFunc[WeekValue]()})
+	RegisterColumnValueCodec("hour", funcCodec{encode: marshalJSON, decode: decodeIntoFunc[Hour]()})
+	RegisterColumnValueCodec("location", funcCodec{encode: marshalJSON, decode: decodeIntoFunc[Location]()})
+	RegisterColumnValueCodec("country", funcCodec{encode: marshalJSON, decode: decodeIntoFunc[Country]()})
+	RegisterColumnValueCodec("tag", funcCodec{encode: marshalJSON, decode: decodeIntoFunc[Tag]()})
+	RegisterColumnValueCodec("file", funcCodec{encode: marshalJSON, decode: decodeIntoFunc[FileValue]()})
+	RegisterColumnValueCodec("board-relation", funcCodec{encode: marshalJSON, decode: decodeIntoFunc[BoardRelation]()})
+	// Mirror columns are read-only and echo whatever their source column
+	// holds, so there's no single shape to decode into - hand back the raw
+	// JSON for the caller to interpret.
+	RegisterColumnValueCodec("mirror", funcCodec{
+		encode: marshalJSON,
+		decode: func(raw json.RawMessage, _ string) (interface{}, error) {
+			return raw, nil
+		},
+	})
+}
+
+// decodeIntoFunc returns a decode function for decodeInto[T], for use where
+// a func value rather than a call is needed (struct literal field init).
+func decodeIntoFunc[T any]() func(json.RawMessage, string) (interface{}, error) {
+	return func(raw json.RawMessage, _ string) (interface{}, error) {
+		return decodeInto[T](raw)
+	}
+}
+
+// columnValueCodec looks up the codec for a column type, returning an error
+// matching DecodeValue's historical "value type not handled" message when
+// none is registered.
+func columnValueCodec(columnType string) (ColumnValueCodec, error) {
+	columnCodecsMu.RLock()
+	codec, found := columnCodecs[columnType]
+	columnCodecsMu.RUnlock()
+	if !found {
+		return nil, errors.New("value type not handled - " + columnType)
+	}
+	return codec, nil
+}
@@ -0,0 +1,133 @@
+package monday
+
+import (
+	"encoding/json"
+	"sync"
+	"testing"
+)
+
+// TestColumnValueCodecRegistry exercises DecodeValue end to end for each
+// built-in column type, verifying the registry wires every codec registered
+// by init() to the right Go shape.
+func TestColumnValueCodecRegistry(t *testing.T) {
+	tests := []struct {
+		columnType string
+		raw        string
+		want       interface{}
+	}{
+		{"text", `"hello"`, "hello"},
+		{"color", `{"index":2}`, StatusIndex{Index: 2}},
+		{"boolean", `{"checked":"true"}`, Checkbox{Checked: "true"}},
+		{"date", `{"date":"2023-01-02","time":"03:04:05"}`, DateTime{Date: "2023-01-02", Time: "03:04:05"}},
+		{"multiple-person", `{"personsAndTeams":[{"id":5,"kind":"person"}]}`, []string{"5"}},
+		{"dropdown", `{"ids":[1,2]}`, []string{"1", "2"}},
+		{"email", `{"email":"a@b.com","text":"a@b.com"}`, Email{Email: "a@b.com", Text: "a@b.com"}},
+		{"phone", `{"phone":"555","countryShortName":"US"}`, Phone{Phone: "555", CountryShortName: "US"}},
+		{"link", `{"url":"http://x","text":"x"}`, Link{Url: "http://x", Text: "x"}},
+		{"long-text", `{"text":"long"}`, LongText{Text: "long"}},
+		{"numeric", `{"value":1.5}`, Numeric{Value: 1.5}},
+		{"rating", `{"rating":4}`, Rating{Rating: 4}},
+		{"timeline", `{"from":"2023-01-01","to":"2023-01-02"}`, Timeline{From: "2023-01-01", To: "2023-01-02"}},
+		{"week", `{"week":{"startDate":"2023-01-02","endDate":"2023-01-08"}}`, WeekValue{Week: Week{StartDate: "2023-01-02", EndDate: "2023-01-08"}}},
+		{"hour", `{"hour":9,"minute":30}`, Hour{Hour: 9, Minute: 30}},
+		{"location", `{"lat":"1","lng":"2","address":"addr"}`, Location{Lat: "1", Lng: "2", Address: "addr"}},
+		{"country", `{"countryCode":"US","countryName":"United States"}`, Country{CountryCode: "US", CountryName: "United States"}},
+		{"tag", `{"tag_ids":[1,2]}`, Tag{TagIds: []int{1, 2}}},
+		{"file", `{"files":[{"name":"f","assetId":9}]}`, FileValue{Files: []FileInfo{{Name: "f", AssetId: 9}}}},
+		{"board-relation", `{"linkedPulseIds":[{"linkedPulseId":7}]}`, BoardRelation{LinkedPulseIds: []LinkedItem{{LinkedPulseId: 7}}}},
+		{"mirror", `{"anything":1}`, json.RawMessage(`{"anything":1}`)},
+	}
+
+	columnMap := ColumnMap{}
+	for _, tt := range tests {
+		columnMap[tt.columnType] = Column{Id: tt.columnType, Type: tt.columnType}
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.columnType, func(t *testing.T) {
+			got, err := DecodeValue(columnMap, ColumnValue{Id: tt.columnType, Value: tt.raw})
+			if err != nil {
+				t.Fatalf("DecodeValue(%q) returned error: %v", tt.columnType, err)
+			}
+			gotJSON, _ := json.Marshal(got)
+			wantJSON, _ := json.Marshal(tt.want)
+			if string(gotJSON) != string(wantJSON) {
+				t.Errorf("DecodeValue(%q) = %s, want %s", tt.columnType, gotJSON, wantJSON)
+			}
+		})
+	}
+}
+
+// TestDecodeValueEmptyValue asserts DecodeValue treats an empty value string
+// as "no value" rather than attempting to decode it.
+func TestDecodeValueEmptyValue(t *testing.T) {
+	got, err := DecodeValue(ColumnMap{"c": {Id: "c", Type: "text"}}, ColumnValue{Id: "c", Value: ""})
+	if err != nil || got != nil {
+		t.Fatalf("DecodeValue with empty value = (%v, %v), want (nil, nil)", got, err)
+	}
+}
+
+// TestDecodeValueUnknownColumn asserts DecodeValue errors when the column id
+// isn't present in the supplied ColumnMap.
+func TestDecodeValueUnknownColumn(t *testing.T) {
+	if _, err := DecodeValue(ColumnMap{}, ColumnValue{Id: "missing", Value: `"x"`}); err == nil {
+		t.Fatal("DecodeValue with unknown column id: got nil error, want non-nil")
+	}
+}
+
+// TestDecodeValueUnregisteredType asserts DecodeValue errors for a column
+// type with no registered codec.
+func TestDecodeValueUnregisteredType(t *testing.T) {
+	columnMap := ColumnMap{"c": {Id: "c", Type: "no-such-type"}}
+	if _, err := DecodeValue(columnMap, ColumnValue{Id: "c", Value: `"x"`}); err == nil {
+		t.Fatal("DecodeValue with unregistered column type: got nil error, want non-nil")
+	}
+}
+
+// TestRegisterColumnValueCodec asserts a caller-registered codec is used by
+// DecodeValue, supporting custom Monday column types.
+func TestRegisterColumnValueCodec(t *testing.T) {
+	type custom struct {
+		Foo string `json:"foo"`
+	}
+	RegisterColumnValueCodec("custom-test-type", funcCodec{
+		encode: marshalJSON,
+		decode: decodeIntoFunc[custom](),
+	})
+
+	columnMap := ColumnMap{"c": {Id: "c", Type: "custom-test-type"}}
+	got, err := DecodeValue(columnMap, ColumnValue{Id: "c", Value: `{"foo":"bar"}`})
+	if err != nil {
+		t.Fatalf("DecodeValue returned error: %v", err)
+	}
+	if got != (custom{Foo: "bar"}) {
+		t.Errorf("DecodeValue = %v, want %v", got, custom{Foo: "bar"})
+	}
+}
+
+// TestColumnValueCodecRegistryConcurrentAccess exercises RegisterColumnValueCodec
+// and DecodeValue from multiple goroutines concurrently, guarding against the
+// registry's backing map being read and written without synchronization.
+func TestColumnValueCodecRegistryConcurrentAccess(t *testing.T) {
+	type custom struct {
+		Foo string `json:"foo"`
+	}
+	columnMap := ColumnMap{"c": {Id: "c", Type: "concurrent-test-type"}}
+
+	var wg sync.WaitGroup
+	for i := 0; i < 50; i++ {
+		wg.Add(2)
+		go func() {
+			defer wg.Done()
+			RegisterColumnValueCodec("concurrent-test-type", funcCodec{
+				encode: marshalJSON,
+				decode: decodeIntoFunc[custom](),
+			})
+		}()
+		go func() {
+			defer wg.Done()
+			DecodeValue(columnMap, ColumnValue{Id: "c", Value: `{"foo":"bar"}`})
+		}()
+	}
+	wg.Wait()
+}
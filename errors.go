@@ -0,0 +1,38 @@
+package monday
+
+import (
+	"fmt"
+	"strings"
+)
+
+// AuthError is returned when Monday rejects a request's API token.
+type AuthError struct {
+	cause error
+}
+
+func (e *AuthError) Error() string {
+	return fmt.Sprintf("monday: authentication failed: %v", e.cause)
+}
+
+func (e *AuthError) Unwrap() error {
+	return e.cause
+}
+
+// classifyError wraps err as an AuthError if it looks like Monday rejected
+// the request's API token; otherwise it's returned unchanged. RateLimitError
+// is classified separately by the caller, since that case already carries
+// a retry duration. There is deliberately no structured error type for
+// Monday's other GraphQL errors (errorCode/extensions): the pinned
+// machinebox/graphql transport only surfaces a bare Message string per
+// error, so a Code/Extensions-bearing type could never be populated without
+// replacing that transport - don't reintroduce one without doing so.
+func classifyError(err error) error {
+	if err == nil {
+		return nil
+	}
+	msg := strings.ToLower(err.Error())
+	if strings.Contains(msg, "401") || strings.Contains(msg, "unauthorized") {
+		return &AuthError{cause: err}
+	}
+	return err
+}
@@ -0,0 +1,36 @@
+package monday
+
+// Logger receives diagnostic output from a Client instead of it writing to
+// stderr/stdout directly. A Client from NewClient uses a no-op Logger, so
+// nothing is logged - including request bodies, which may contain PII -
+// unless a caller opts in with SetLogger.
+type Logger interface {
+	Debugf(format string, args ...interface{})
+	Warnf(format string, args ...interface{})
+	Errorf(format string, args ...interface{})
+}
+
+type noopLogger struct{}
+
+func (noopLogger) Debugf(string, ...interface{}) {}
+func (noopLogger) Warnf(string, ...interface{})  {}
+func (noopLogger) Errorf(string, ...interface{}) {}
+
+// SetLogger sets the Logger a Client reports request diagnostics to. Pass
+// nil to go back to the no-op default.
+func (c *Client) SetLogger(logger Logger) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if logger == nil {
+		logger = noopLogger{}
+	}
+	c.logger = logger
+}
+
+// getLogger returns the Client's current Logger, reading it under c.mu so it
+// can't race with a concurrent SetLogger.
+func (c *Client) getLogger() Logger {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	return c.logger
+}
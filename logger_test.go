@@ -0,0 +1,33 @@
+package monday
+
+import (
+	"sync"
+	"testing"
+)
+
+type countingLogger struct{}
+
+func (countingLogger) Debugf(string, ...interface{}) {}
+func (countingLogger) Warnf(string, ...interface{})  {}
+func (countingLogger) Errorf(string, ...interface{}) {}
+
+// TestClientLoggerConcurrentAccess exercises SetLogger and getLogger from
+// multiple goroutines concurrently, guarding against the logger field being
+// read and written without synchronization.
+func TestClientLoggerConcurrentAccess(t *testing.T) {
+	c := NewClient("token")
+
+	var wg sync.WaitGroup
+	for i := 0; i < 50; i++ {
+		wg.Add(2)
+		go func() {
+			defer wg.Done()
+			c.SetLogger(countingLogger{})
+		}()
+		go func() {
+			defer wg.Done()
+			c.getLogger().Debugf("test")
+		}()
+	}
+	wg.Wait()
+}
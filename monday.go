@@ -5,8 +5,9 @@ import (
 	"encoding/json"
 	"errors"
 	"fmt"
-	"log"
 	"strconv"
+	"sync"
+	"time"
 
 	"github.com/machinebox/graphql"
 )
@@ -68,66 +69,173 @@ const Endpoint = "https://api.monday.com/v2/"
 type Client struct {
 	token  string
 	client *graphql.Client
+
+	mu             sync.Mutex
+	defaultTimeout time.Duration
+
+	retryPolicy     RetryPolicy
+	trackComplexity bool
+	lastComplexity  Complexity
+	haveComplexity  bool
+
+	logger Logger
 }
 
 // NewClient returns a authenticated client for the Monday.com API
 func NewClient(authToken string) *Client {
-	return &Client{token: authToken, client: graphql.NewClient(Endpoint)}
+	return &Client{
+		token:       authToken,
+		client:      graphql.NewClient(Endpoint),
+		retryPolicy: DefaultRetryPolicy,
+		logger:      noopLogger{},
+	}
+}
+
+// SetDefaultTimeout sets the timeout applied to a request made through a
+// non-Context method, or through a Context method whose ctx carries no
+// deadline of its own. A zero duration (the default) means no timeout is
+// applied and such requests can block indefinitely.
+func (c *Client) SetDefaultTimeout(d time.Duration) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.defaultTimeout = d
 }
 
 // RunRequest executes request and decodes response into response parm (address of object)
 func (c *Client) runRequest(req *graphql.Request, response interface{}) error {
+	return c.runRequestContext(context.Background(), req, response)
+}
+
+// runRequestContext is runRequest with a caller-supplied context, used by
+// every ...Context method and by Execute so Bindings run under the same
+// deadline/cancellation as any other request. If ctx has no deadline, the
+// Client's default timeout (if any) is applied. If complexity tracking is
+// enabled, it also waits out Monday's rate limit before sending -
+// pre-emptively, if ctx carries a WithEstimatedCost that would exceed the
+// remaining budget, or reactively, once a prior response reports the budget
+// exhausted. Whether or not tracking is enabled, a request rejected as rate
+// limited is retried per the Client's RetryPolicy before giving up with a
+// RateLimitError.
+func (c *Client) runRequestContext(ctx context.Context, req *graphql.Request, response interface{}) error {
 	req.Header.Set("Cache-Control", "no-cache")
 	req.Header.Set("Authorization", c.token)
 	req.Header.Set("Content-Type", "application/json")
-	ctx := context.Background()
-	err := c.client.Run(ctx, req, response)
-	return err
+
+	c.mu.Lock()
+	timeout := c.defaultTimeout
+	policy := c.retryPolicy
+	trackComplexity := c.trackComplexity
+	logger := c.logger
+	mustWaitForReset := trackComplexity && c.haveComplexity && c.lastComplexity.After <= 0
+	if !mustWaitForReset && trackComplexity && c.haveComplexity {
+		if estimatedCost, ok := estimatedCostFrom(ctx); ok && estimatedCost > c.lastComplexity.After {
+			mustWaitForReset = true
+		}
+	}
+	c.mu.Unlock()
+
+	if timeout > 0 {
+		if _, hasDeadline := ctx.Deadline(); !hasDeadline {
+			var cancel context.CancelFunc
+			ctx, cancel = context.WithTimeout(ctx, timeout)
+			defer cancel()
+		}
+	}
+
+	if mustWaitForReset {
+		if err := sleepContext(ctx, complexityResetWindow); err != nil {
+			return err
+		}
+	}
+
+	capture := &complexityCapture{target: response}
+	var err error
+	for attempt := 0; ; attempt++ {
+		err = c.client.Run(ctx, req, capture)
+		if trackComplexity && capture.hasComplexity {
+			c.mu.Lock()
+			c.lastComplexity = capture.complexity
+			c.haveComplexity = true
+			c.mu.Unlock()
+		}
+		if err == nil || !isRateLimited(err) || attempt >= policy.MaxRetries {
+			break
+		}
+		if sleepErr := sleepContext(ctx, backoff(policy, attempt)); sleepErr != nil {
+			return sleepErr
+		}
+	}
+	if err != nil && isRateLimited(err) {
+		err = &RateLimitError{Retry: backoff(policy, policy.MaxRetries), cause: err}
+	}
+	if err != nil {
+		logger.Errorf("monday: request failed: %v", err)
+		return classifyError(err)
+	}
+	return nil
 }
 
 // GetUsers returns []User for all users.
 func (c *Client) GetUsers() ([]User, error) {
-	req := graphql.NewRequest(`
+	return c.GetUsersContext(context.Background())
+}
+
+// GetUsersContext is GetUsers with a caller-supplied context.
+func (c *Client) GetUsersContext(ctx context.Context) ([]User, error) {
+	req := graphql.NewRequest(c.injectComplexity(`
 	    query {
+            ` + complexityPlaceholder + `
             users {
                 id name email
             }
         }
-    `)
+    `))
 	var response struct {
 		Users []User `json:"users"`
 	}
-	err := c.runRequest(req, &response)
+	err := c.runRequestContext(ctx, req, &response)
 	return response.Users, err
 }
 
 // GetBoards returns []Board for all boards.
 func (c *Client) GetBoards() ([]Board, error) {
-	req := graphql.NewRequest(`
+	return c.GetBoardsContext(context.Background())
+}
+
+// GetBoardsContext is GetBoards with a caller-supplied context.
+func (c *Client) GetBoardsContext(ctx context.Context) ([]Board, error) {
+	req := graphql.NewRequest(c.injectComplexity(`
 	    query {
+            ` + complexityPlaceholder + `
             boards {
                 id name
             }
         }
-    `)
+    `))
 	var response struct {
 		Boards []Board `json:"boards"`
 	}
-	err := c.runRequest(req, &response)
+	err := c.runRequestContext(ctx, req, &response)
 	return response.Boards, err
 }
 
 // GetGroups returns []Group for specified board.
 func (c *Client) GetGroups(boardId int) ([]Group, error) {
-	req := graphql.NewRequest(`
+	return c.GetGroupsContext(context.Background(), boardId)
+}
+
+// GetGroupsContext is GetGroups with a caller-supplied context.
+func (c *Client) GetGroupsContext(ctx context.Context, boardId int) ([]Group, error) {
+	req := graphql.NewRequest(c.injectComplexity(`
 		query ($boardId: [Int]) {
+			` + complexityPlaceholder + `
 			boards (ids: $boardId) {
 				groups {
 					id title
-				}	
+				}
             }
         }
-	`)
+	`))
 	req.Var("boardId", []int{boardId})
 	type board struct {
 		Groups []Group `json:"groups"`
@@ -135,19 +243,25 @@ func (c *Client) GetGroups(boardId int) ([]Group, error) {
 	var response struct {
 		Boards []board `json:"boards"`
 	}
-	err := c.runRequest(req, &response)
+	err := c.runRequestContext(ctx, req, &response)
 	return response.Boards[0].Groups, err
 }
 
 // GetColumns returns []Column for specified boardId.
 func (c *Client) GetColumns(boardId int) ([]Column, error) {
-	req := graphql.NewRequest(`
+	return c.GetColumnsContext(context.Background(), boardId)
+}
+
+// GetColumnsContext is GetColumns with a caller-supplied context.
+func (c *Client) GetColumnsContext(ctx context.Context, boardId int) ([]Column, error) {
+	req := graphql.NewRequest(c.injectComplexity(`
 	    query ($boardId: [Int]) {
+            ` + complexityPlaceholder + `
             boards (ids: $boardId) {
                 columns {id title type settings_str}
             }
         }
-    `)
+    `))
 	req.Var("boardId", []int{boardId})
 	type board struct {
 		Columns []Column `json:"columns"`
@@ -155,7 +269,7 @@ func (c *Client) GetColumns(boardId int) ([]Column, error) {
 	var response struct {
 		Boards []board `json:"boards"`
 	}
-	err := c.runRequest(req, &response)
+	err := c.runRequestContext(ctx, req, &response)
 	return response.Boards[0].Columns, err
 }
 
@@ -208,15 +322,21 @@ func BuildPeople(userIds ...int) People {
 
 // AddItem adds 1 item to specified board/group. The id of the added item is returned.
 func (c *Client) AddItem(boardId int, groupId string, itemName string, columnValues map[string]interface{}) (string, error) {
-	req := graphql.NewRequest(`
+	return c.AddItemContext(context.Background(), boardId, groupId, itemName, columnValues)
+}
+
+// AddItemContext is AddItem with a caller-supplied context.
+func (c *Client) AddItemContext(ctx context.Context, boardId int, groupId string, itemName string, columnValues map[string]interface{}) (string, error) {
+	req := graphql.NewRequest(c.injectComplexity(`
         mutation ($boardId: Int!, $groupId: String!, $itemName: String!, $colValues: JSON!) {
+            ` + complexityPlaceholder + `
             create_item (board_id: $boardId, group_id: $groupId, item_name: $itemName, column_values: $colValues ) {
                 id
             }
         }
-    `)
+    `))
 	jsonValues, _ := json.Marshal(&columnValues)
-	log.Println(string(jsonValues))
+	c.getLogger().Debugf("AddItem: columnValues=%s", jsonValues)
 
 	req.Var("boardId", boardId)
 	req.Var("groupId", groupId)
@@ -229,24 +349,30 @@ func (c *Client) AddItem(boardId int, groupId string, itemName string, columnVal
 	var response struct {
 		CreateItem ItemId `json:"create_item"`
 	}
-	err := c.runRequest(req, &response)
+	err := c.runRequestContext(ctx, req, &response)
 	return response.CreateItem.Id, err
 }
 
 // AddItemUpdate adds an update entry to specified item.
 func (c *Client) AddItemUpdate(itemId string, msg string) error {
+	return c.AddItemUpdateContext(context.Background(), itemId, msg)
+}
+
+// AddItemUpdateContext is AddItemUpdate with a caller-supplied context.
+func (c *Client) AddItemUpdateContext(ctx context.Context, itemId string, msg string) error {
 	intItemId, err := strconv.Atoi(itemId)
 	if err != nil {
-		log.Println("AddItemUpdate - bad itemId", err)
+		c.getLogger().Warnf("AddItemUpdate: invalid itemId %q: %v", itemId, err)
 		return err
 	}
-	req := graphql.NewRequest(`
+	req := graphql.NewRequest(c.injectComplexity(`
 		mutation ($itemId: Int!, $body: String!) {
+			` + complexityPlaceholder + `
 			create_update (item_id: $itemId, body: $body ) {
 				id
 			}
 		}
-	`)
+	`))
 	req.Var("itemId", intItemId)
 	req.Var("body", msg)
 
@@ -256,168 +382,136 @@ func (c *Client) AddItemUpdate(itemId string, msg string) error {
 	var response struct {
 		CreateUpdate UpdateId `json:"create_update"`
 	}
-	err = c.runRequest(req, &response)
+	err = c.runRequestContext(ctx, req, &response)
 	return err
 }
 
-// GetItems returns []Item for all items in specified board.
+// itemsPageSize is the number of items requested per page by GetItems. Monday
+// paginates the items field server-side, so a board with more items than this
+// requires more than one request - see Paginator.
+const itemsPageSize = 100
+
+// GetItems returns []Item for all items in specified board, paging through
+// Monday's items field under the hood via Paginator so boards larger than a
+// single page still come back complete.
 func (c *Client) GetItems(boardId int) ([]Item, error) {
-	req := graphql.NewRequest(`	
-		query ($boardId: [Int]) {
-			boards (ids: $boardId){
-				# items (limit: 10) {
-				items () {
-					id
-					group {	id }
-					name
-					# column_values (ids: ["text", "status", "check"]) {  -- to get specific columns  
-					column_values { 
-						id value
-					}
-				}	
-			}
-		}	
-	`)
-	req.Var("boardId", []int{boardId})
+	return c.GetItemsContext(context.Background(), boardId)
+}
 
-	type group struct {
-		Id string `json:"id"`
-	}
-	type itemData struct {
-		Id           string        `json:"id"`
-		Group        group         `json:"group"`
-		Name         string        `json:"name"`
-		ColumnValues []ColumnValue `json:"column_values"`
-	}
-	type boardItems struct {
-		Items []itemData `json:"items"`
-	}
-	var response struct {
-		Boards []boardItems `json:"boards"`
-	}
-	items := make([]Item, 0, 1000)
-	err := c.runRequest(req, &response)
-	if err != nil {
-		fmt.Println("GetItems Failed -", err)
-		return items, err
-	}
-	var responseItems []itemData = response.Boards[0].Items
-	for _, responseItem := range responseItems {
-		items = append(items, Item{
-			Id:           responseItem.Id,
-			GroupId:      responseItem.Group.Id,
-			Name:         responseItem.Name,
-			ColumnValues: responseItem.ColumnValues,
-		})
+// GetItemsContext is GetItems with a caller-supplied context.
+func (c *Client) GetItemsContext(ctx context.Context, boardId int) ([]Item, error) {
+	paginator := NewPaginator[itemsEnvelope, Item](c, itemsBinding{}, unwrapItems, boardId, itemsPageSize)
+	items := make([]Item, 0, itemsPageSize)
+	for paginator.HasNext() {
+		page, err := paginator.Next(ctx)
+		if err != nil {
+			c.getLogger().Errorf("GetItems: %v", err)
+			return items, err
+		}
+		if len(page) == 0 {
+			break
+		}
+		items = append(items, page...)
 	}
 	return items, nil
 }
 
-// DecodeValues converts column value returned from Monday to a string value
-// 	color(status) returns index of label chosen, ex. "3"
-// 	boolean(checkbox) returns "true" or "false"
-// 	date returns "2019-05-22"
-// Types "multi-person" and "dropdown" may have multiple values.
-//		for these, a slice of strings is returned
-// Use CreateColumnMap to create the columnMap (contains info for all columns in board)
-func DecodeValue(columnMap ColumnMap, columnValue ColumnValue) (result1 string, result2 []string, err error) {
+// DecodeValue converts a column value returned from Monday into its typed Go
+// value, dispatching on the column's type through the ColumnValueCodec
+// registry (see RegisterColumnValueCodec):
+//
+//	text returns string
+//	color(status) returns StatusIndex
+//	boolean(checkbox) returns Checkbox
+//	date returns DateTime
+//	multiple-person and dropdown return []string, consistent with
+//	DecodePeople/DecodeDropDown
+//
+// Any other registered type returns its own struct, e.g. Email, Timeline,
+// Location, except mirror, which returns its raw json.RawMessage. Use
+// CreateColumnMap to create the columnMap (contains info for
+// all columns in board).
+func DecodeValue(columnMap ColumnMap, columnValue ColumnValue) (interface{}, error) {
 	if columnValue.Value == "" {
-		return
+		return nil, nil
 	}
 	column, found := columnMap[columnValue.Id]
 	if !found {
-		err = errors.New("invalid column id - " + columnValue.Id)
-		return
-	}
-	inVal := []byte(columnValue.Value) // convert input value (string) to []byte, required by json.Unmarshal
-	switch column.Type {
-	case "text":
-		result1 = columnValue.Value
-	case "color": // status, return index of value
-		var val StatusIndex
-		err = json.Unmarshal(inVal, &val)
-		result1 = strconv.Itoa(val.Index)
-	case "boolean": // checkbox, return true or false
-		var val Checkbox
-		err = json.Unmarshal(inVal, &val)
-		result1 = val.Checked
-	case "date":
-		var val DateTime
-		err = json.Unmarshal(inVal, &val)
-		result1 = val.Date
-	case "multiple-person":
-		result2 = DecodePeople(columnValue.Value)
-	case "dropdown":
-		result2 = DecodeDropDown(columnValue.Value)
-	default:
-		err = errors.New("value type not handled - " + column.Type)
+		return nil, errors.New("invalid column id - " + columnValue.Id)
+	}
+	codec, err := columnValueCodec(column.Type)
+	if err != nil {
+		return nil, err
 	}
-	return
+	return codec.Decode(json.RawMessage(columnValue.Value), column.Settings)
 }
 
 // DecodePeople returns user id of each person assigned. Use GetUsers to get all user id values.
-func DecodePeople(valueIn string) []string {
+func DecodePeople(valueIn string) ([]string, error) {
 	var val People
-	err := json.Unmarshal([]byte(valueIn), &val)
-	if err != nil {
-		log.Println("DecodePeople Unmarshal Failed, ", err)
-		return nil
+	if err := json.Unmarshal([]byte(valueIn), &val); err != nil {
+		return nil, err
 	}
 	result := make([]string, len(val.PersonsAndTeams))
 	for i, person := range val.PersonsAndTeams {
 		result[i] = strconv.Itoa(person.Id)
 	}
-	return result
+	return result, nil
 }
 
 // DecodeDropDown returns ids of value selections. Use DecodeLabels to list Index value for each dropdown label.
-func DecodeDropDown(valueIn string) []string {
+func DecodeDropDown(valueIn string) ([]string, error) {
 	var val struct {
 		Ids []int `json:"ids"`
 	}
-	err := json.Unmarshal([]byte(valueIn), &val)
-	if err != nil {
-		log.Println("DecodeDropDown Unmarshal Failed, ", err)
-		return nil
+	if err := json.Unmarshal([]byte(valueIn), &val); err != nil {
+		return nil, err
 	}
 	result := make([]string, len(val.Ids))
 	for i, id := range val.Ids {
 		result[i] = strconv.Itoa(id)
 	}
-	return result
+	return result, nil
 }
 
-// DecodeLabels displays index value of all labels for a column. Uses column settings_str (see GetColumns).
-// Use for Status(color) and Dropdown fields.
-func DecodeLabels(settings_str, columnType string) {
-	var statusLabels struct {
-		Labels         map[string]string `json:"labels"`             // index: label
-		LabelPositions map[string]int    `json:"label_positions_v2"` // index: position
-	}
-	type dropdownEntry struct {
-		Id   int    `json:"id"`
-		Name string `json:"name"`
-	}
-	var dropdownLabels struct {
-		Labels []dropdownEntry `json:"labels"`
-	}
+// DropdownLabel is one entry of a "dropdown" column's label list, as
+// returned by DecodeLabels.
+type DropdownLabel struct {
+	Id   int    `json:"id"`
+	Name string `json:"name"`
+}
 
-	if columnType == "color" {
-		err := json.Unmarshal([]byte(settings_str), &statusLabels)
-		if err != nil {
-			log.Fatal("DecodeLabels Failed", err)
+// DecodeLabels returns the label info held in a column's settings_str (see
+// GetColumns): for a "color" (status) column, a map of label name to index,
+// ready to pass to BuildStatusIndex; for a "dropdown" column, its ordered
+// []DropdownLabel. Any other columnType is an error.
+func DecodeLabels(settingsStr, columnType string) (interface{}, error) {
+	switch columnType {
+	case "color":
+		var statusLabels struct {
+			Labels map[string]string `json:"labels"` // index: label
 		}
+		if err := json.Unmarshal([]byte(settingsStr), &statusLabels); err != nil {
+			return nil, err
+		}
+		labels := make(map[string]int, len(statusLabels.Labels))
 		for index, label := range statusLabels.Labels {
-			fmt.Println(index, label)
+			i, err := strconv.Atoi(index)
+			if err != nil {
+				return nil, err
+			}
+			labels[label] = i
 		}
-	}
-	if columnType == "dropdown" {
-		err := json.Unmarshal([]byte(settings_str), &dropdownLabels)
-		if err != nil {
-			log.Fatal("DecodeLabels Failed", err)
+		return labels, nil
+	case "dropdown":
+		var dropdownLabels struct {
+			Labels []DropdownLabel `json:"labels"`
 		}
-		for _, label := range dropdownLabels.Labels {
-			fmt.Println(label.Id, label.Name)
+		if err := json.Unmarshal([]byte(settingsStr), &dropdownLabels); err != nil {
+			return nil, err
 		}
+		return dropdownLabels.Labels, nil
+	default:
+		return nil, fmt.Errorf("DecodeLabels: unsupported column type - %s", columnType)
 	}
 }
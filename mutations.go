@@ -0,0 +1,293 @@
+package monday
+
+import (
+	"context"
+	"encoding/json"
+	"strconv"
+
+	"github.com/machinebox/graphql"
+)
+
+// ChangeColumnValue sets a single column's value on an item. value is
+// marshaled to JSON the same way AddItem encodes its columnValues map - use
+// one of the Build* helpers for columns that expect a structured value.
+// Monday's change_column_value mutation is scoped to a board, so boardId is
+// required even though the item id alone identifies the item.
+func (c *Client) ChangeColumnValue(boardId int, itemId, columnId string, value interface{}) error {
+	return c.ChangeColumnValueContext(context.Background(), boardId, itemId, columnId, value)
+}
+
+// ChangeColumnValueContext is ChangeColumnValue with a caller-supplied context.
+func (c *Client) ChangeColumnValueContext(ctx context.Context, boardId int, itemId, columnId string, value interface{}) error {
+	intItemId, err := strconv.Atoi(itemId)
+	if err != nil {
+		return err
+	}
+	jsonValue, err := json.Marshal(value)
+	if err != nil {
+		return err
+	}
+	req := graphql.NewRequest(c.injectComplexity(`
+		mutation ($boardId: Int!, $itemId: Int!, $columnId: String!, $value: JSON!) {
+			` + complexityPlaceholder + `
+			change_column_value (board_id: $boardId, item_id: $itemId, column_id: $columnId, value: $value) {
+				id
+			}
+		}
+	`))
+	req.Var("boardId", boardId)
+	req.Var("itemId", intItemId)
+	req.Var("columnId", columnId)
+	req.Var("value", string(jsonValue))
+
+	type ItemId struct {
+		Id string `json:"id"`
+	}
+	var response struct {
+		ChangeColumnValue ItemId `json:"change_column_value"`
+	}
+	return c.runRequestContext(ctx, req, &response)
+}
+
+// ChangeMultipleColumnValues sets several column values on an item in one
+// call, reusing the same columnValues JSON encoding as AddItem.
+func (c *Client) ChangeMultipleColumnValues(boardId int, itemId string, values map[string]interface{}) error {
+	return c.ChangeMultipleColumnValuesContext(context.Background(), boardId, itemId, values)
+}
+
+// ChangeMultipleColumnValuesContext is ChangeMultipleColumnValues with a
+// caller-supplied context.
+func (c *Client) ChangeMultipleColumnValuesContext(ctx context.Context, boardId int, itemId string, values map[string]interface{}) error {
+	intItemId, err := strconv.Atoi(itemId)
+	if err != nil {
+		return err
+	}
+	jsonValues, err := json.Marshal(&values)
+	if err != nil {
+		return err
+	}
+	req := graphql.NewRequest(c.injectComplexity(`
+		mutation ($boardId: Int!, $itemId: Int!, $colValues: JSON!) {
+			` + complexityPlaceholder + `
+			change_multiple_column_values (board_id: $boardId, item_id: $itemId, column_values: $colValues) {
+				id
+			}
+		}
+	`))
+	req.Var("boardId", boardId)
+	req.Var("itemId", intItemId)
+	req.Var("colValues", string(jsonValues))
+
+	type ItemId struct {
+		Id string `json:"id"`
+	}
+	var response struct {
+		ChangeMultipleColumnValues ItemId `json:"change_multiple_column_values"`
+	}
+	return c.runRequestContext(ctx, req, &response)
+}
+
+// DeleteItem permanently deletes the specified item.
+func (c *Client) DeleteItem(itemId string) error {
+	return c.DeleteItemContext(context.Background(), itemId)
+}
+
+// DeleteItemContext is DeleteItem with a caller-supplied context.
+func (c *Client) DeleteItemContext(ctx context.Context, itemId string) error {
+	intItemId, err := strconv.Atoi(itemId)
+	if err != nil {
+		return err
+	}
+	req := graphql.NewRequest(c.injectComplexity(`
+		mutation ($itemId: Int!) {
+			` + complexityPlaceholder + `
+			delete_item (item_id: $itemId) {
+				id
+			}
+		}
+	`))
+	req.Var("itemId", intItemId)
+
+	type ItemId struct {
+		Id string `json:"id"`
+	}
+	var response struct {
+		DeleteItem ItemId `json:"delete_item"`
+	}
+	return c.runRequestContext(ctx, req, &response)
+}
+
+// ArchiveItem archives the specified item.
+func (c *Client) ArchiveItem(itemId string) error {
+	return c.ArchiveItemContext(context.Background(), itemId)
+}
+
+// ArchiveItemContext is ArchiveItem with a caller-supplied context.
+func (c *Client) ArchiveItemContext(ctx context.Context, itemId string) error {
+	intItemId, err := strconv.Atoi(itemId)
+	if err != nil {
+		return err
+	}
+	req := graphql.NewRequest(c.injectComplexity(`
+		mutation ($itemId: Int!) {
+			` + complexityPlaceholder + `
+			archive_item (item_id: $itemId) {
+				id
+			}
+		}
+	`))
+	req.Var("itemId", intItemId)
+
+	type ItemId struct {
+		Id string `json:"id"`
+	}
+	var response struct {
+		ArchiveItem ItemId `json:"archive_item"`
+	}
+	return c.runRequestContext(ctx, req, &response)
+}
+
+// MoveItemToGroup moves the specified item to a different group on the same board.
+func (c *Client) MoveItemToGroup(itemId, groupId string) error {
+	return c.MoveItemToGroupContext(context.Background(), itemId, groupId)
+}
+
+// MoveItemToGroupContext is MoveItemToGroup with a caller-supplied context.
+func (c *Client) MoveItemToGroupContext(ctx context.Context, itemId, groupId string) error {
+	intItemId, err := strconv.Atoi(itemId)
+	if err != nil {
+		return err
+	}
+	req := graphql.NewRequest(c.injectComplexity(`
+		mutation ($itemId: Int!, $groupId: String!) {
+			` + complexityPlaceholder + `
+			move_item_to_group (item_id: $itemId, group_id: $groupId) {
+				id
+			}
+		}
+	`))
+	req.Var("itemId", intItemId)
+	req.Var("groupId", groupId)
+
+	type ItemId struct {
+		Id string `json:"id"`
+	}
+	var response struct {
+		MoveItemToGroup ItemId `json:"move_item_to_group"`
+	}
+	return c.runRequestContext(ctx, req, &response)
+}
+
+// DuplicateItem duplicates the specified item within its board. The id of
+// the new item is returned. If withUpdates is true, the item's updates are
+// duplicated along with it.
+func (c *Client) DuplicateItem(boardId int, itemId string, withUpdates bool) (string, error) {
+	return c.DuplicateItemContext(context.Background(), boardId, itemId, withUpdates)
+}
+
+// DuplicateItemContext is DuplicateItem with a caller-supplied context.
+func (c *Client) DuplicateItemContext(ctx context.Context, boardId int, itemId string, withUpdates bool) (string, error) {
+	intItemId, err := strconv.Atoi(itemId)
+	if err != nil {
+		return "", err
+	}
+	req := graphql.NewRequest(c.injectComplexity(`
+		mutation ($boardId: Int!, $itemId: Int!, $withUpdates: Boolean) {
+			` + complexityPlaceholder + `
+			duplicate_item (board_id: $boardId, item_id: $itemId, with_updates: $withUpdates) {
+				id
+			}
+		}
+	`))
+	req.Var("boardId", boardId)
+	req.Var("itemId", intItemId)
+	req.Var("withUpdates", withUpdates)
+
+	type ItemId struct {
+		Id string `json:"id"`
+	}
+	var response struct {
+		DuplicateItem ItemId `json:"duplicate_item"`
+	}
+	err = c.runRequestContext(ctx, req, &response)
+	return response.DuplicateItem.Id, err
+}
+
+// CreateBoard creates a new board and returns it. kind is one of Monday's
+// board_kind enum values: "public", "private" or "share".
+func (c *Client) CreateBoard(name, kind string) (Board, error) {
+	return c.CreateBoardContext(context.Background(), name, kind)
+}
+
+// CreateBoardContext is CreateBoard with a caller-supplied context.
+func (c *Client) CreateBoardContext(ctx context.Context, name, kind string) (Board, error) {
+	req := graphql.NewRequest(c.injectComplexity(`
+		mutation ($boardName: String!, $boardKind: BoardKind!) {
+			` + complexityPlaceholder + `
+			create_board (board_name: $boardName, board_kind: $boardKind) {
+				id name
+			}
+		}
+	`))
+	req.Var("boardName", name)
+	req.Var("boardKind", kind)
+
+	var response struct {
+		CreateBoard Board `json:"create_board"`
+	}
+	err := c.runRequestContext(ctx, req, &response)
+	return response.CreateBoard, err
+}
+
+// CreateGroup creates a new group on the specified board and returns it.
+func (c *Client) CreateGroup(boardId int, groupName string) (Group, error) {
+	return c.CreateGroupContext(context.Background(), boardId, groupName)
+}
+
+// CreateGroupContext is CreateGroup with a caller-supplied context.
+func (c *Client) CreateGroupContext(ctx context.Context, boardId int, groupName string) (Group, error) {
+	req := graphql.NewRequest(c.injectComplexity(`
+		mutation ($boardId: Int!, $groupName: String!) {
+			` + complexityPlaceholder + `
+			create_group (board_id: $boardId, group_name: $groupName) {
+				id title
+			}
+		}
+	`))
+	req.Var("boardId", boardId)
+	req.Var("groupName", groupName)
+
+	var response struct {
+		CreateGroup Group `json:"create_group"`
+	}
+	err := c.runRequestContext(ctx, req, &response)
+	return response.CreateGroup, err
+}
+
+// CreateColumn creates a new column on the specified board and returns it.
+// columnType is one of Monday's column_type enum values, e.g. "text",
+// "status" or "date".
+func (c *Client) CreateColumn(boardId int, title, columnType string) (Column, error) {
+	return c.CreateColumnContext(context.Background(), boardId, title, columnType)
+}
+
+// CreateColumnContext is CreateColumn with a caller-supplied context.
+func (c *Client) CreateColumnContext(ctx context.Context, boardId int, title, columnType string) (Column, error) {
+	req := graphql.NewRequest(c.injectComplexity(`
+		mutation ($boardId: Int!, $title: String!, $columnType: ColumnType!) {
+			` + complexityPlaceholder + `
+			create_column (board_id: $boardId, title: $title, column_type: $columnType) {
+				id title type settings_str
+			}
+		}
+	`))
+	req.Var("boardId", boardId)
+	req.Var("title", title)
+	req.Var("columnType", columnType)
+
+	var response struct {
+		CreateColumn Column `json:"create_column"`
+	}
+	err := c.runRequestContext(ctx, req, &response)
+	return response.CreateColumn, err
+}
@@ -0,0 +1,134 @@
+package monday
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+)
+
+// captureVarsServer returns an httptest.Server that decodes each request's
+// GraphQL variables into got and answers with an empty "data" object, so
+// tests can assert on exactly what a mutation method sent.
+func captureVarsServer(t *testing.T, got *map[string]interface{}) *httptest.Server {
+	t.Helper()
+	return httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		var body struct {
+			Variables map[string]interface{} `json:"variables"`
+		}
+		if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+			t.Fatalf("decoding request body: %v", err)
+		}
+		*got = body.Variables
+		json.NewEncoder(w).Encode(map[string]interface{}{"data": map[string]interface{}{}})
+	}))
+}
+
+func TestChangeColumnValueContextVariables(t *testing.T) {
+	var got map[string]interface{}
+	ts := captureVarsServer(t, &got)
+	defer ts.Close()
+
+	client := newTestClient(ts)
+	if err := client.ChangeColumnValueContext(context.Background(), 1, "42", "status", BuildStatusIndex(3)); err != nil {
+		t.Fatalf("ChangeColumnValueContext returned error: %v", err)
+	}
+
+	if got["boardId"] != float64(1) {
+		t.Errorf("boardId = %v, want 1", got["boardId"])
+	}
+	if got["itemId"] != float64(42) {
+		t.Errorf("itemId = %v, want 42 (board-scoped: boardId and itemId both sent)", got["itemId"])
+	}
+	if got["columnId"] != "status" {
+		t.Errorf("columnId = %v, want \"status\"", got["columnId"])
+	}
+	if got["value"] != `{"index":3}` {
+		t.Errorf("value = %v, want %q", got["value"], `{"index":3}`)
+	}
+}
+
+func TestChangeColumnValueContextInvalidItemId(t *testing.T) {
+	client := newTestClient(httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		t.Fatal("request sent to server despite invalid itemId")
+	})))
+	err := client.ChangeColumnValueContext(context.Background(), 1, "not-a-number", "status", BuildStatusIndex(3))
+	if err == nil {
+		t.Fatal("ChangeColumnValueContext with non-numeric itemId: got nil error, want non-nil")
+	}
+}
+
+func TestChangeMultipleColumnValuesContextVariables(t *testing.T) {
+	var got map[string]interface{}
+	ts := captureVarsServer(t, &got)
+	defer ts.Close()
+
+	client := newTestClient(ts)
+	values := map[string]interface{}{"text": "hi"}
+	if err := client.ChangeMultipleColumnValuesContext(context.Background(), 1, "42", values); err != nil {
+		t.Fatalf("ChangeMultipleColumnValuesContext returned error: %v", err)
+	}
+
+	if got["boardId"] != float64(1) || got["itemId"] != float64(42) {
+		t.Errorf("boardId/itemId = %v/%v, want 1/42", got["boardId"], got["itemId"])
+	}
+	if got["colValues"] != `{"text":"hi"}` {
+		t.Errorf("colValues = %v, want %q", got["colValues"], `{"text":"hi"}`)
+	}
+}
+
+func TestDuplicateItemContextVariables(t *testing.T) {
+	var got map[string]interface{}
+	ts := captureVarsServer(t, &got)
+	defer ts.Close()
+
+	client := newTestClient(ts)
+	if _, err := client.DuplicateItemContext(context.Background(), 1, "42", true); err != nil {
+		t.Fatalf("DuplicateItemContext returned error: %v", err)
+	}
+
+	if got["boardId"] != float64(1) || got["itemId"] != float64(42) || got["withUpdates"] != true {
+		t.Errorf("vars = %v, want boardId=1 itemId=42 withUpdates=true", got)
+	}
+}
+
+// TestMutationsInvalidItemId asserts every mutation method taking a string
+// itemId rejects a non-numeric one before sending a request.
+func TestMutationsInvalidItemId(t *testing.T) {
+	failServer := func() *httptest.Server {
+		return httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			t.Fatal("request sent to server despite invalid itemId")
+		}))
+	}
+
+	tests := []struct {
+		name string
+		call func(c *Client) error
+	}{
+		{"ChangeColumnValue", func(c *Client) error {
+			return c.ChangeColumnValueContext(context.Background(), 1, "bad", "status", BuildStatusIndex(3))
+		}},
+		{"ChangeMultipleColumnValues", func(c *Client) error {
+			return c.ChangeMultipleColumnValuesContext(context.Background(), 1, "bad", map[string]interface{}{"text": "hi"})
+		}},
+		{"AddItemUpdate", func(c *Client) error { return c.AddItemUpdateContext(context.Background(), "bad", "msg") }},
+		{"DeleteItem", func(c *Client) error { return c.DeleteItemContext(context.Background(), "bad") }},
+		{"ArchiveItem", func(c *Client) error { return c.ArchiveItemContext(context.Background(), "bad") }},
+		{"MoveItemToGroup", func(c *Client) error { return c.MoveItemToGroupContext(context.Background(), "bad", "group") }},
+		{"DuplicateItem", func(c *Client) error {
+			_, err := c.DuplicateItemContext(context.Background(), 1, "bad", false)
+			return err
+		}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ts := failServer()
+			defer ts.Close()
+			if err := tt.call(newTestClient(ts)); err == nil {
+				t.Fatalf("%s with non-numeric itemId: got nil error, want non-nil", tt.name)
+			}
+		})
+	}
+}
@@ -0,0 +1,123 @@
+package monday
+
+import "context"
+
+// PageRequest is the request shape accepted by Bindings that page through a
+// Monday connection: the board to query plus the page/limit pair Monday's
+// items field expects.
+type PageRequest struct {
+	BoardId int
+	Page    int
+	Limit   int
+}
+
+// Paginator streams paged results from a Binding whose Req is a PageRequest,
+// so callers can walk a large board without loading every item into one
+// slice up front. Res is the binding's raw response envelope; unwrap pulls
+// the []T of interest out of it.
+type Paginator[Res any, T any] struct {
+	client  *Client
+	binding Binding[PageRequest, Res]
+	unwrap  func(Res) []T
+	req     PageRequest
+	done    bool
+}
+
+// NewPaginator returns a Paginator that fetches boardId's results through
+// binding, limit items at a time, starting at page 1.
+func NewPaginator[Res any, T any](client *Client, binding Binding[PageRequest, Res], unwrap func(Res) []T, boardId, limit int) *Paginator[Res, T] {
+	return &Paginator[Res, T]{
+		client:  client,
+		binding: binding,
+		unwrap:  unwrap,
+		req:     PageRequest{BoardId: boardId, Page: 1, Limit: limit},
+	}
+}
+
+// HasNext reports whether a call to Next is expected to return more results.
+func (p *Paginator[Res, T]) HasNext() bool {
+	return !p.done
+}
+
+// Next fetches and returns the next page. A page shorter than the
+// Paginator's limit marks the end of the result set: Next returns it
+// normally, but HasNext reports false afterwards.
+func (p *Paginator[Res, T]) Next(ctx context.Context) ([]T, error) {
+	if p.done {
+		return nil, nil
+	}
+	res, err := Execute[PageRequest, Res](ctx, p.client, p.binding, p.req)
+	if err != nil {
+		return nil, err
+	}
+	items := p.unwrap(res)
+	if len(items) < p.req.Limit {
+		p.done = true
+	}
+	p.req.Page++
+	return items, nil
+}
+
+// itemsEnvelope is the response envelope for itemsBinding.
+type itemsEnvelope struct {
+	Boards []struct {
+		Items []itemRecord `json:"items"`
+	} `json:"boards"`
+}
+
+// itemRecord mirrors the shape itemsBinding's document asks Monday for; it
+// is converted to an Item by unwrapItems.
+type itemRecord struct {
+	Id    string `json:"id"`
+	Group struct {
+		Id string `json:"id"`
+	} `json:"group"`
+	Name         string        `json:"name"`
+	ColumnValues []ColumnValue `json:"column_values"`
+}
+
+// itemsBinding is the built-in Binding backing GetItems; it fetches one page
+// of a board's items using Monday's page/limit pagination on the items field.
+type itemsBinding struct{}
+
+func (itemsBinding) Document() string {
+	return `
+	    query ($boardId: [Int], $page: Int!, $limit: Int!) {
+	        ` + complexityPlaceholder + `
+	        boards (ids: $boardId) {
+	            items (page: $page, limit: $limit) {
+	                id
+	                group { id }
+	                name
+	                column_values { id value }
+	            }
+	        }
+	    }
+	`
+}
+
+func (itemsBinding) Vars(req PageRequest) map[string]interface{} {
+	return map[string]interface{}{
+		"boardId": []int{req.BoardId},
+		"page":    req.Page,
+		"limit":   req.Limit,
+	}
+}
+
+// unwrapItems pulls the requested page of Items out of an itemsEnvelope.
+func unwrapItems(env itemsEnvelope) []Item {
+	if len(env.Boards) == 0 {
+		return nil
+	}
+	records := env.Boards[0].Items
+	items := make([]Item, len(records))
+	for i, r := range records {
+		items[i] = Item{
+			Id:           r.Id,
+			GroupId:      r.Group.Id,
+			Name:         r.Name,
+			ColumnValues: r.ColumnValues,
+		}
+	}
+	return items
+}
@@ -0,0 +1,117 @@
+package monday
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/machinebox/graphql"
+)
+
+type fakePageEnvelope struct {
+	Items []string `json:"items"`
+}
+
+func unwrapFakePageItems(env fakePageEnvelope) []string {
+	return env.Items
+}
+
+type fakePageBinding struct{}
+
+func (fakePageBinding) Document() string { return `query { ` + complexityPlaceholder + ` }` }
+func (fakePageBinding) Vars(req PageRequest) map[string]interface{} {
+	return map[string]interface{}{"page": req.Page, "limit": req.Limit}
+}
+
+// newTestClient returns a Client whose requests are sent to ts instead of
+// Monday's real endpoint.
+func newTestClient(ts *httptest.Server) *Client {
+	return &Client{client: graphql.NewClient(ts.URL), logger: noopLogger{}}
+}
+
+// TestPaginatorNextShortPageEndsStream asserts HasNext goes false exactly
+// once a page shorter than the limit comes back, and that the short page
+// itself is still returned.
+func TestPaginatorNextShortPageEndsStream(t *testing.T) {
+	pages := [][]string{{"a", "b"}, {"c"}}
+	requestCount := 0
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		items := pages[requestCount]
+		requestCount++
+		json.NewEncoder(w).Encode(map[string]interface{}{
+			"data": fakePageEnvelope{Items: items},
+		})
+	}))
+	defer ts.Close()
+
+	client := newTestClient(ts)
+	paginator := NewPaginator[fakePageEnvelope, string](client, fakePageBinding{}, unwrapFakePageItems, 1, 2)
+
+	if !paginator.HasNext() {
+		t.Fatal("HasNext() before first Next = false, want true")
+	}
+
+	got1, err := paginator.Next(context.Background())
+	if err != nil {
+		t.Fatalf("first Next returned error: %v", err)
+	}
+	if len(got1) != 2 {
+		t.Fatalf("first page = %v, want length 2", got1)
+	}
+	if !paginator.HasNext() {
+		t.Fatal("HasNext() after a full page = false, want true")
+	}
+
+	got2, err := paginator.Next(context.Background())
+	if err != nil {
+		t.Fatalf("second Next returned error: %v", err)
+	}
+	if len(got2) != 1 {
+		t.Fatalf("second (short) page = %v, want length 1", got2)
+	}
+	if paginator.HasNext() {
+		t.Fatal("HasNext() after a short page = true, want false")
+	}
+
+	got3, err := paginator.Next(context.Background())
+	if err != nil || got3 != nil {
+		t.Fatalf("Next() once done = (%v, %v), want (nil, nil)", got3, err)
+	}
+	if requestCount != 2 {
+		t.Fatalf("server received %d requests, want 2 (Next() after done shouldn't call it again)", requestCount)
+	}
+}
+
+// TestPaginatorNextPaging asserts successive Next calls request increasing
+// page numbers.
+func TestPaginatorNextPaging(t *testing.T) {
+	var gotPages []float64
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		var body struct {
+			Variables map[string]interface{} `json:"variables"`
+		}
+		json.NewDecoder(r.Body).Decode(&body)
+		gotPages = append(gotPages, body.Variables["page"].(float64))
+		json.NewEncoder(w).Encode(map[string]interface{}{
+			"data": fakePageEnvelope{Items: []string{"x", "y"}},
+		})
+	}))
+	defer ts.Close()
+
+	client := newTestClient(ts)
+	paginator := NewPaginator[fakePageEnvelope, string](client, fakePageBinding{}, unwrapFakePageItems, 1, 2)
+
+	if _, err := paginator.Next(context.Background()); err != nil {
+		t.Fatalf("Next() returned error: %v", err)
+	}
+	if _, err := paginator.Next(context.Background()); err != nil {
+		t.Fatalf("Next() returned error: %v", err)
+	}
+
+	want := []float64{1, 2}
+	if len(gotPages) != len(want) || gotPages[0] != want[0] || gotPages[1] != want[1] {
+		t.Errorf("requested pages = %v, want %v", gotPages, want)
+	}
+}
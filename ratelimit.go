@@ -0,0 +1,194 @@
+package monday
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"math/rand"
+	"strings"
+	"time"
+)
+
+// Complexity holds the budget info Monday reports alongside a response when
+// complexity tracking is enabled: Before/After are the remaining budget
+// before and after the query ran, and Query is the cost the query itself
+// consumed.
+type Complexity struct {
+	Before int `json:"before"`
+	After  int `json:"after"`
+	Query  int `json:"query"`
+}
+
+// complexityFragment is the GraphQL field injectComplexity splices into a
+// built-in query/mutation document in place of complexityPlaceholder, so
+// Client.ComplexityRemaining reflects Monday's rolling per-minute budget once
+// TrackComplexity is enabled.
+const complexityFragment = "complexity { before after query }"
+
+// complexityPlaceholder marks the spot in a built-in document where
+// injectComplexity splices in complexityFragment, or removes entirely, based
+// on whether the Client has TrackComplexity enabled - so callers who never
+// opt in don't have the complexity field sent on every request.
+const complexityPlaceholder = "@@complexity@@"
+
+// injectComplexity replaces complexityPlaceholder in doc with
+// complexityFragment if c has TrackComplexity enabled, or strips it
+// otherwise.
+func (c *Client) injectComplexity(doc string) string {
+	c.mu.Lock()
+	trackComplexity := c.trackComplexity
+	c.mu.Unlock()
+	if trackComplexity {
+		return strings.Replace(doc, complexityPlaceholder, complexityFragment, 1)
+	}
+	return strings.Replace(doc, complexityPlaceholder, "", 1)
+}
+
+// complexityResetWindow is how long runRequestContext waits before sending a
+// request when the last-seen complexity budget was exhausted. Monday's
+// budget resets on a rolling per-minute window.
+const complexityResetWindow = time.Minute
+
+// RetryPolicy controls how runRequestContext retries a request that Monday
+// rejected for exceeding its rolling complexity budget.
+type RetryPolicy struct {
+	MaxRetries int           // retries after the initial attempt; 0 disables retrying
+	BaseDelay  time.Duration // delay before the first retry
+	MaxDelay   time.Duration // upper bound on the delay between retries
+}
+
+// DefaultRetryPolicy retries up to 3 times with exponential backoff starting
+// at 1 second, capped at 30 seconds.
+var DefaultRetryPolicy = RetryPolicy{MaxRetries: 3, BaseDelay: time.Second, MaxDelay: 30 * time.Second}
+
+// RateLimitError is returned when Monday's rate limit is hit and the
+// Client's RetryPolicy has been exhausted. Retry is how long the caller
+// should wait before trying again.
+type RateLimitError struct {
+	Retry time.Duration
+	cause error
+}
+
+func (e *RateLimitError) Error() string {
+	return fmt.Sprintf("monday: rate limited, retry after %s: %v", e.Retry, e.cause)
+}
+
+func (e *RateLimitError) Unwrap() error {
+	return e.cause
+}
+
+// isRateLimited reports whether err looks like one of Monday's rate-limit
+// responses: a ComplexityException in the GraphQL errors array, or an HTTP
+// 429.
+func isRateLimited(err error) bool {
+	if err == nil {
+		return false
+	}
+	msg := strings.ToLower(err.Error())
+	return strings.Contains(msg, "complexityexception") ||
+		strings.Contains(msg, "429") ||
+		strings.Contains(msg, "rate limit")
+}
+
+// WithRetryPolicy sets the policy used to retry requests rejected for
+// exceeding Monday's complexity budget, and returns c so it can be chained
+// off NewClient.
+func (c *Client) WithRetryPolicy(p RetryPolicy) *Client {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.retryPolicy = p
+	return c
+}
+
+// TrackComplexity enables or disables requesting Monday's complexity budget
+// alongside every built-in query/mutation, making it available via
+// ComplexityRemaining and throttling requests once the budget is exhausted.
+func (c *Client) TrackComplexity(enabled bool) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.trackComplexity = enabled
+}
+
+// ComplexityRemaining returns the remaining complexity budget as of the last
+// response that included a complexity block, and whether one has been seen
+// yet (it hasn't if TrackComplexity was never enabled).
+func (c *Client) ComplexityRemaining() (int, bool) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if !c.haveComplexity {
+		return 0, false
+	}
+	return c.lastComplexity.After, true
+}
+
+// complexityCapture wraps a caller's response target so the single
+// json.Unmarshal pass graphql.Client.Run performs can both populate it and,
+// if present, pull the sibling "complexity" field out of the same payload.
+type complexityCapture struct {
+	target        interface{}
+	complexity    Complexity
+	hasComplexity bool
+}
+
+func (cc *complexityCapture) UnmarshalJSON(data []byte) error {
+	if err := json.Unmarshal(data, cc.target); err != nil {
+		return err
+	}
+	var wrapper struct {
+		Complexity *Complexity `json:"complexity"`
+	}
+	if err := json.Unmarshal(data, &wrapper); err != nil {
+		return err
+	}
+	if wrapper.Complexity != nil {
+		cc.complexity = *wrapper.Complexity
+		cc.hasComplexity = true
+	}
+	return nil
+}
+
+// estimatedCostKey is the context.Value key WithEstimatedCost stores a
+// request's estimated complexity cost under.
+type estimatedCostKey struct{}
+
+// WithEstimatedCost returns a copy of ctx carrying cost as the estimated
+// complexity Monday will charge for the request made with it. When
+// TrackComplexity is enabled, runRequestContext compares cost against the
+// budget remaining as of the last response (see ComplexityRemaining) and, if
+// cost would exceed it, sleeps until the next reset window before sending -
+// the same pre-emptive throttling applied reactively once the budget is
+// actually exhausted.
+func WithEstimatedCost(ctx context.Context, cost int) context.Context {
+	return context.WithValue(ctx, estimatedCostKey{}, cost)
+}
+
+// estimatedCostFrom returns the cost stored by WithEstimatedCost, if any.
+func estimatedCostFrom(ctx context.Context) (int, bool) {
+	cost, ok := ctx.Value(estimatedCostKey{}).(int)
+	return cost, ok
+}
+
+// backoff returns the delay before retry attempt n (0-based): exponential
+// from policy.BaseDelay, capped at policy.MaxDelay, plus up to 20% jitter so
+// concurrent callers don't retry in lockstep.
+func backoff(policy RetryPolicy, attempt int) time.Duration {
+	delay := policy.BaseDelay << uint(attempt)
+	if policy.MaxDelay > 0 && delay > policy.MaxDelay {
+		delay = policy.MaxDelay
+	}
+	jitter := time.Duration(rand.Int63n(int64(delay)/5 + 1))
+	return delay + jitter
+}
+
+// sleepContext waits for d, returning early with ctx.Err() if ctx is done
+// first.
+func sleepContext(ctx context.Context, d time.Duration) error {
+	timer := time.NewTimer(d)
+	defer timer.Stop()
+	select {
+	case <-timer.C:
+		return nil
+	case <-ctx.Done():
+		return ctx.Err()
+	}
+}
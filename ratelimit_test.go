@@ -0,0 +1,77 @@
+package monday
+
+import (
+	"errors"
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestBackoff(t *testing.T) {
+	policy := RetryPolicy{BaseDelay: time.Second, MaxDelay: 5 * time.Second}
+
+	tests := []struct {
+		attempt int
+		wantMin time.Duration
+		wantMax time.Duration
+	}{
+		{0, time.Second, time.Second + time.Second/5},
+		{1, 2 * time.Second, 2*time.Second + 2*time.Second/5},
+		{10, 5 * time.Second, 5*time.Second + 5*time.Second/5}, // capped by MaxDelay
+	}
+
+	for _, tt := range tests {
+		d := backoff(policy, tt.attempt)
+		if d < tt.wantMin || d > tt.wantMax {
+			t.Errorf("backoff(policy, %d) = %s, want between %s and %s", tt.attempt, d, tt.wantMin, tt.wantMax)
+		}
+	}
+}
+
+func TestBackoffZeroMaxDelayUncapped(t *testing.T) {
+	policy := RetryPolicy{BaseDelay: time.Second}
+	d := backoff(policy, 10)
+	if d < 1024*time.Second {
+		t.Errorf("backoff with MaxDelay 0 = %s, want uncapped (>= %s)", d, 1024*time.Second)
+	}
+}
+
+func TestIsRateLimited(t *testing.T) {
+	tests := []struct {
+		name string
+		err  error
+		want bool
+	}{
+		{"nil", nil, false},
+		{"complexity exception", errors.New("graphql: ComplexityException: query too complex"), true},
+		{"http 429", errors.New("request failed with status 429"), true},
+		{"rate limit phrase", errors.New("monday: Rate Limit Exceeded"), true},
+		{"unrelated error", errors.New("graphql: field not found"), false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := isRateLimited(tt.err); got != tt.want {
+				t.Errorf("isRateLimited(%v) = %v, want %v", tt.err, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestInjectComplexity(t *testing.T) {
+	doc := "query { " + complexityPlaceholder + " users { id } }"
+
+	c := NewClient("token")
+	if got := c.injectComplexity(doc); strings.Contains(got, complexityPlaceholder) || strings.Contains(got, complexityFragment) {
+		t.Errorf("injectComplexity with TrackComplexity disabled = %q, want neither placeholder nor fragment present", got)
+	}
+
+	c.TrackComplexity(true)
+	got := c.injectComplexity(doc)
+	if strings.Contains(got, complexityPlaceholder) {
+		t.Errorf("injectComplexity with TrackComplexity enabled = %q, still contains placeholder", got)
+	}
+	if !strings.Contains(got, complexityFragment) {
+		t.Errorf("injectComplexity with TrackComplexity enabled = %q, want it to contain %q", got, complexityFragment)
+	}
+}
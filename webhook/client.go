@@ -0,0 +1,105 @@
+// Package webhook adds Monday webhook subscriptions (create_webhook /
+// delete_webhook) and an http.Handler that verifies the challenge
+// handshake, decodes event payloads, and dispatches them to registered
+// handlers - so a program can react to board changes instead of polling.
+package webhook
+
+import (
+	"context"
+	"strconv"
+
+	"github.com/mhe/monday"
+)
+
+// EventType identifies a Monday webhook event, both when subscribing (see
+// CreateWebhook) and when dispatching a received payload (see Mux.On).
+type EventType string
+
+const (
+	EventCreateItem        EventType = "create_item"
+	EventChangeColumnValue EventType = "change_column_value"
+	EventCreateUpdate      EventType = "create_update"
+	EventChangeStatus      EventType = "change_status_column_value"
+)
+
+type createWebhookRequest struct {
+	BoardId int
+	Url     string
+	Event   EventType
+	Config  map[string]interface{}
+}
+
+type createWebhookBinding struct{}
+
+func (createWebhookBinding) Document() string {
+	return `
+		mutation ($boardId: Int!, $url: String!, $event: WebhookEventType!, $config: JSON) {
+			create_webhook (board_id: $boardId, url: $url, event: $event, config: $config) {
+				id board_id
+			}
+		}
+	`
+}
+
+func (createWebhookBinding) Vars(req createWebhookRequest) map[string]interface{} {
+	vars := map[string]interface{}{
+		"boardId": req.BoardId,
+		"url":     req.Url,
+		"event":   req.Event,
+	}
+	if req.Config != nil {
+		vars["config"] = req.Config
+	}
+	return vars
+}
+
+type createWebhookEnvelope struct {
+	CreateWebhook struct {
+		Id string `json:"id"`
+	} `json:"create_webhook"`
+}
+
+// CreateWebhook subscribes url to event on boardId, optionally scoped by
+// config (e.g. {"columnId": "status"} for change_column_value). The new
+// webhook's id is returned.
+func CreateWebhook(ctx context.Context, client *monday.Client, boardId int, url string, event EventType, config map[string]interface{}) (string, error) {
+	res, err := monday.Execute[createWebhookRequest, createWebhookEnvelope](ctx, client, createWebhookBinding{}, createWebhookRequest{
+		BoardId: boardId,
+		Url:     url,
+		Event:   event,
+		Config:  config,
+	})
+	return res.CreateWebhook.Id, err
+}
+
+type deleteWebhookBinding struct{}
+
+func (deleteWebhookBinding) Document() string {
+	return `
+		mutation ($id: Int!) {
+			delete_webhook (id: $id) {
+				id board_id
+			}
+		}
+	`
+}
+
+func (deleteWebhookBinding) Vars(id int) map[string]interface{} {
+	return map[string]interface{}{"id": id}
+}
+
+type deleteWebhookEnvelope struct {
+	DeleteWebhook struct {
+		Id string `json:"id"`
+	} `json:"delete_webhook"`
+}
+
+// DeleteWebhook removes the webhook subscription with the given id.
+func DeleteWebhook(ctx context.Context, client *monday.Client, id string) error {
+	intId, err := strconv.Atoi(id)
+	if err != nil {
+		return err
+	}
+	_, err = monday.Execute[int, deleteWebhookEnvelope](ctx, client, deleteWebhookBinding{}, intId)
+	return err
+}
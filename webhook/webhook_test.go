@@ -0,0 +1,105 @@
+package webhook
+
+import (
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/base64"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+)
+
+func sign(secret string, body []byte) string {
+	mac := hmac.New(sha256.New, []byte(secret))
+	mac.Write(body)
+	return base64.StdEncoding.EncodeToString(mac.Sum(nil))
+}
+
+// TestMuxServeHTTPChallenge asserts the challenge handshake is answered
+// verbatim, even when the Mux has a secret configured, since Monday's
+// handshake POST isn't signed.
+func TestMuxServeHTTPChallenge(t *testing.T) {
+	m := NewMux("shared-secret")
+	rec := httptest.NewRecorder()
+	req := httptest.NewRequest(http.MethodPost, "/", strings.NewReader(`{"challenge":"abc123"}`))
+
+	m.ServeHTTP(rec, req)
+
+	if rec.Code != http.StatusOK {
+		t.Fatalf("status = %d, want %d", rec.Code, http.StatusOK)
+	}
+	if got, want := rec.Body.String(), "{\"challenge\":\"abc123\"}\n"; got != want {
+		t.Errorf("body = %q, want %q", got, want)
+	}
+}
+
+// TestMuxServeHTTPInvalidSignature asserts an event payload with a missing
+// or mismatched signature is rejected with 401 when a secret is configured.
+func TestMuxServeHTTPInvalidSignature(t *testing.T) {
+	m := NewMux("shared-secret")
+	body := `{"event":{"type":"create_item","boardId":1,"pulseId":2,"pulseName":"x","userId":3}}`
+	rec := httptest.NewRecorder()
+	req := httptest.NewRequest(http.MethodPost, "/", strings.NewReader(body))
+	req.Header.Set("Authorization", "not-the-right-signature")
+
+	m.ServeHTTP(rec, req)
+
+	if rec.Code != http.StatusUnauthorized {
+		t.Fatalf("status = %d, want %d", rec.Code, http.StatusUnauthorized)
+	}
+}
+
+// TestMuxServeHTTPEventDispatch asserts a correctly signed event payload is
+// decoded and dispatched to the Handler registered for its EventType.
+func TestMuxServeHTTPEventDispatch(t *testing.T) {
+	m := NewMux("shared-secret")
+	var got ItemCreatedEvent
+	calls := 0
+	m.On(EventCreateItem, func(ctx context.Context, event interface{}) {
+		calls++
+		got = event.(ItemCreatedEvent)
+	})
+
+	body := []byte(`{"event":{"type":"create_item","boardId":1,"pulseId":2,"pulseName":"x","userId":3}}`)
+	rec := httptest.NewRecorder()
+	req := httptest.NewRequest(http.MethodPost, "/", strings.NewReader(string(body)))
+	req.Header.Set("Authorization", sign("shared-secret", body))
+
+	m.ServeHTTP(rec, req)
+
+	if rec.Code != http.StatusOK {
+		t.Fatalf("status = %d, want %d", rec.Code, http.StatusOK)
+	}
+	if calls != 1 {
+		t.Fatalf("handler called %d times, want 1", calls)
+	}
+	want := ItemCreatedEvent{BoardId: 1, PulseId: 2, PulseName: "x", UserId: 3}
+	if got != want {
+		t.Errorf("event = %+v, want %+v", got, want)
+	}
+}
+
+// TestMuxServeHTTPNoSecretSkipsVerification asserts a Mux with no secret
+// configured dispatches events without requiring a signature.
+func TestMuxServeHTTPNoSecretSkipsVerification(t *testing.T) {
+	m := NewMux("")
+	calls := 0
+	m.On(EventCreateUpdate, func(ctx context.Context, event interface{}) {
+		calls++
+	})
+
+	body := `{"event":{"type":"create_update","boardId":1,"pulseId":2,"updateId":3,"body":"hi","userId":4}}`
+	rec := httptest.NewRecorder()
+	req := httptest.NewRequest(http.MethodPost, "/", strings.NewReader(body))
+
+	m.ServeHTTP(rec, req)
+
+	if rec.Code != http.StatusOK {
+		t.Fatalf("status = %d, want %d", rec.Code, http.StatusOK)
+	}
+	if calls != 1 {
+		t.Fatalf("handler called %d times, want 1", calls)
+	}
+}